@@ -0,0 +1,122 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGoTypeFor(t *testing.T) {
+	cases := []struct {
+		name         string
+		field        field
+		wantGoType   string
+		wantFromOdoo string
+	}{
+		{"many2one", field{Name: "partner_id", Type: "many2one"}, "types.Many2One", "record.PartnerId.FromOdoo"},
+		{"one2many", field{Name: "line_ids", Type: "one2many"}, "[]int64", "types.ToMany2Many"},
+		{"many2many", field{Name: "tag_ids", Type: "many2many"}, "[]int64", "types.ToMany2Many"},
+		{"boolean", field{Name: "active", Type: "boolean"}, "bool", "record.Active, _"},
+		{"integer", field{Name: "sequence", Type: "integer"}, "int64", "record.Sequence, _"},
+		{"float", field{Name: "amount", Type: "float"}, "float64", "record.Amount, _"},
+		{"monetary", field{Name: "price", Type: "monetary"}, "float64", "record.Price, _"},
+		{"char", field{Name: "name", Type: "char"}, "types.String", "record.Name.FromOdoo"},
+		{"unknown", field{Name: "custom", Type: "json"}, "interface{}", "record.Custom ="},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			goName := pascalCase(c.field.Name)
+			goType, fromOdoo := goTypeFor(c.field, goName)
+			if goType != c.wantGoType {
+				t.Errorf("goType = %q, want %q", goType, c.wantGoType)
+			}
+			if !strings.Contains(fromOdoo, c.wantFromOdoo) {
+				t.Errorf("fromOdoo = %q, want it to contain %q", fromOdoo, c.wantFromOdoo)
+			}
+		})
+	}
+}
+
+func TestPascalCase(t *testing.T) {
+	cases := map[string]string{
+		"partner_id": "PartnerId",
+		"res.users":  "ResUsers",
+		"name":       "Name",
+	}
+	for in, want := range cases {
+		if got := pascalCase(in); got != want {
+			t.Errorf("pascalCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestNewModelData(t *testing.T) {
+	fields := []field{
+		{Name: "id", Type: "integer"},
+		{Name: "name", Type: "char", Required: true},
+		{Name: "partner_id", Type: "many2one"},
+		{Name: "active", Type: "boolean"},
+	}
+
+	data := newModelData("odoomodels", "res.partner", fields)
+
+	if data.StructName != "ResPartner" || data.RepoName != "ResPartnerRepo" || data.PatchName != "ResPartnerPatch" {
+		t.Fatalf("got %+v, want ResPartner/ResPartnerRepo/ResPartnerPatch naming", data)
+	}
+	if !data.UsesTypes {
+		t.Fatal("expected UsesTypes to be true when a many2one or char field is present")
+	}
+	// "id" must be skipped: the generated struct already declares it explicitly.
+	if len(data.Fields) != 3 {
+		t.Fatalf("got %d fields, want 3 (id excluded)", len(data.Fields))
+	}
+}
+
+func TestNewModelData_NoTypesPackageNeeded(t *testing.T) {
+	fields := []field{
+		{Name: "sequence", Type: "integer"},
+		{Name: "active", Type: "boolean"},
+	}
+	data := newModelData("odoomodels", "res.currency.rate", fields)
+	if data.UsesTypes {
+		t.Fatal("expected UsesTypes to be false when no field needs the types package")
+	}
+}
+
+func TestWriteModel(t *testing.T) {
+	fields := []field{
+		{Name: "id", Type: "integer"},
+		{Name: "name", Type: "char"},
+		{Name: "partner_id", Type: "many2one"},
+		{Name: "tag_ids", Type: "many2many"},
+		{Name: "active", Type: "boolean"},
+	}
+	data := newModelData("odoomodels", "res.partner", fields)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "res_partner.go")
+	if err := writeModel(path, data); err != nil {
+		t.Fatal(err)
+	}
+
+	source, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(source)
+
+	for _, want := range []string{
+		"package odoomodels",
+		"github.com/domenicostragapede/go-odoo/types",
+		"type ResPartner struct",
+		"func (record *ResPartner) FromOdoo(raw map[string]interface{}) error",
+		"func ResPartnerRepository(client *odoo.Client) *ResPartnerRepo",
+		"func (repo *ResPartnerRepo) Search(ctx context.Context, domain odoo.Domain) ([]ResPartner, error)",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated source missing %q\n---\n%s", want, got)
+		}
+	}
+}
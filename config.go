@@ -1,14 +1,63 @@
 package odoo
 
+import (
+	"net/http"
+	"time"
+)
+
+// Supported wire protocols for ClientConfig.Protocol.
+const (
+	ProtocolXMLRPC  = "xmlrpc"
+	ProtocolJSONRPC = "jsonrpc"
+)
+
 // Define the base structure for connect to an odoo server.
 type ClientConfig struct {
 	Url      string
 	Db       string
 	Username string
 	Password string
+	// Protocol selects the wire protocol used to reach the Odoo server:
+	// ProtocolXMLRPC (default, used when left empty) or ProtocolJSONRPC.
+	Protocol string
+	// HTTPClient lets callers inject custom TLS configs, proxies or
+	// instrumentation. The jsonrpc transport uses it directly, defaulting
+	// to http.DefaultClient; the xmlrpc transport only borrows its
+	// Transport (falling back to http.DefaultTransport), since
+	// github.com/kolo/xmlrpc builds its own *http.Client around whichever
+	// http.RoundTripper it's given.
+	HTTPClient *http.Client
+	// Timeout bounds every RPC that isn't made through a context already
+	// carrying its own deadline. Zero means no timeout is enforced.
+	Timeout time.Duration
+	// AutoReauth controls whether the client re-authenticates and retries
+	// a call once it detects the Odoo session was invalidated server-side
+	// (restart, worker recycle, password change). Defaults to true; set
+	// to a pointer to false to disable.
+	AutoReauth *bool
+	// MaxRetries caps how many re-authenticate-and-retry attempts
+	// AutoReauth makes before giving up. Defaults to 1 when left at zero.
+	MaxRetries int
+	// OnReauth, when set, is called with the old and new uid every time
+	// AutoReauth successfully re-authenticates, for observability.
+	OnReauth func(old int64, new int64)
 }
 
 // Check if a configuration is valid.
 func (config *ClientConfig) IsValid() bool {
 	return config.Url != "" && config.Db != "" && config.Username != "" && config.Password != ""
 }
+
+// autoReauth reports whether AutoReauth is enabled, defaulting to true
+// when left unset.
+func (config *ClientConfig) autoReauth() bool {
+	return config.AutoReauth == nil || *config.AutoReauth
+}
+
+// maxRetries returns MaxRetries, defaulting to 1 when left at zero.
+func (config *ClientConfig) maxRetries() int {
+	if config.MaxRetries > 0 {
+		return config.MaxRetries
+	}
+	return 1
+}
@@ -0,0 +1,102 @@
+package odoo
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+)
+
+// DBService exposes the database-management RPCs Odoo publishes under its
+// "db" service (/xmlrpc/2/db, or service "db" over JSON-RPC): listing,
+// creating, duplicating, dropping, dumping and restoring databases. These
+// operations are authenticated with the server's master password rather
+// than a user session, so unlike the ORM methods on Client they never go
+// through ExecuteKw or its isAuthenticated() gate.
+type DBService struct {
+	client *Client
+}
+
+// DB returns a DBService bound to the client's Transport.
+func (client *Client) DB() *DBService {
+	return &DBService{client: client}
+}
+
+func (db *DBService) call(ctx context.Context, method string, args Args) (interface{}, error) {
+	return db.client.transport.Call(ctx, "db", method, args)
+}
+
+// List returns the names of the databases hosted by the Odoo server.
+func (db *DBService) List(ctx context.Context) ([]string, error) {
+	response, err := db.call(ctx, "list", Args{})
+	if err != nil {
+		return nil, err
+	}
+	items := response.([]interface{})
+	result := make([]string, len(items))
+	for i, item := range items {
+		result[i] = item.(string)
+	}
+	return result, nil
+}
+
+// Create asks the server to create a new database named dbName, optionally
+// loading demo data, with an admin account set up for lang and adminPwd.
+func (db *DBService) Create(ctx context.Context, masterPwd string, dbName string, demo bool, lang string, adminPwd string) error {
+	_, err := db.call(ctx, "create_database", Args{masterPwd, dbName, demo, lang, adminPwd})
+	return err
+}
+
+// Duplicate copies the source database into a new database named dest.
+func (db *DBService) Duplicate(ctx context.Context, masterPwd string, source string, dest string) error {
+	_, err := db.call(ctx, "duplicate_database", Args{masterPwd, source, dest})
+	return err
+}
+
+// Drop deletes dbName from the server.
+func (db *DBService) Drop(ctx context.Context, masterPwd string, dbName string) error {
+	_, err := db.call(ctx, "drop", Args{masterPwd, dbName})
+	return err
+}
+
+// Dump retrieves a backup of dbName in the given format ("zip" or "dump")
+// and returns it as a streamable ReadCloser. The server encodes the dump
+// as base64 over the wire; Dump decodes it before handing it back.
+func (db *DBService) Dump(ctx context.Context, masterPwd string, dbName string, format string) (io.ReadCloser, error) {
+	response, err := db.call(ctx, "dump", Args{masterPwd, dbName, format})
+	if err != nil {
+		return nil, err
+	}
+	encoded, ok := response.(string)
+	if !ok {
+		return nil, fmt.Errorf("odoo: unexpected dump response type %T", response)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(decoded)), nil
+}
+
+// Restore loads a dump previously obtained from Dump into a new database
+// named dbName. copy marks the restored database as a duplicate, letting
+// Odoo assign it a fresh UUID instead of reusing the original's.
+func (db *DBService) Restore(ctx context.Context, masterPwd string, dbName string, data io.Reader, copy bool) error {
+	raw, err := io.ReadAll(data)
+	if err != nil {
+		return err
+	}
+	encoded := base64.StdEncoding.EncodeToString(raw)
+	_, err = db.call(ctx, "restore", Args{masterPwd, dbName, encoded, copy})
+	return err
+}
+
+// ServerVersion returns the Odoo server's version string.
+func (db *DBService) ServerVersion(ctx context.Context) (string, error) {
+	response, err := db.call(ctx, "server_version", Args{})
+	if err != nil {
+		return "", err
+	}
+	return response.(string), nil
+}
@@ -0,0 +1,113 @@
+package odoo
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newDBTestServer serves a minimal canned /jsonrpc responder good enough
+// to drive DBService's methods without a live Odoo.
+func newDBTestServer(t *testing.T, dumpPayload string) *httptest.Server {
+	t.Helper()
+	encodedDump := base64.StdEncoding.EncodeToString([]byte(dumpPayload))
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req jsonrpcRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding jsonrpc request: %v", err)
+		}
+
+		var result interface{}
+		switch {
+		case req.Params.Service == "common" && req.Params.Method == "authenticate":
+			result = 1
+		case req.Params.Service == "db":
+			switch req.Params.Method {
+			case "list":
+				result = []interface{}{"db1", "db2"}
+			case "create_database", "duplicate_database", "drop", "restore":
+				result = true
+			case "dump":
+				result = encodedDump
+			case "server_version":
+				result = "15.0"
+			default:
+				t.Fatalf("unexpected db method %q", req.Params.Method)
+			}
+		default:
+			t.Fatalf("unexpected call %s/%s", req.Params.Service, req.Params.Method)
+		}
+
+		_ = json.NewEncoder(w).Encode(jsonrpcResponse{Jsonrpc: "2.0", Id: req.Id, Result: result})
+	}))
+}
+
+func TestDBService(t *testing.T) {
+	const dumpPayload = "dummy dump data"
+	server := newDBTestServer(t, dumpPayload)
+	defer server.Close()
+
+	client, err := NewClient(&ClientConfig{
+		Url:      server.URL,
+		Db:       "test",
+		Username: "admin",
+		Password: "admin",
+		Protocol: ProtocolJSONRPC,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	db := client.DB()
+
+	names, err := db.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 2 || names[0] != "db1" || names[1] != "db2" {
+		t.Fatalf("got %v, want [db1 db2]", names)
+	}
+
+	if err := db.Create(ctx, "master", "newdb", false, "en_US", "admin"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Duplicate(ctx, "master", "db1", "db1-copy"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Drop(ctx, "master", "db1-copy"); err != nil {
+		t.Fatal(err)
+	}
+
+	dump, err := db.Dump(ctx, "master", "db1", "zip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dump.Close()
+	raw, err := io.ReadAll(dump)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(raw) != dumpPayload {
+		t.Fatalf("got dump %q, want %q", raw, dumpPayload)
+	}
+
+	if err := db.Restore(ctx, "master", "restored", bytes.NewReader(raw), true); err != nil {
+		t.Fatal(err)
+	}
+
+	version, err := db.ServerVersion(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != "15.0" {
+		t.Fatalf("got version %q, want %q", version, "15.0")
+	}
+}
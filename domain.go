@@ -0,0 +1,200 @@
+package odoo
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Defines the odoo domain operator AND
+const OpAND = "&"
+
+// Defines the odoo domain operator OR
+const OpOR = "|"
+
+// Defines the odoo domain operator NOT
+const OpNOT = "!"
+
+// allowedOperators lists the comparison operators Odoo accepts in a domain
+// clause (https://www.odoo.com/documentation/13.0/reference/orm.html#search-domains).
+var allowedOperators = map[string]bool{
+	"=": true, "!=": true, ">": true, ">=": true, "<": true, "<=": true,
+	"=?": true, "=like": true, "like": true, "not like": true,
+	"ilike": true, "not ilike": true, "=ilike": true,
+	"in": true, "not in": true, "child_of": true, "parent_of": true,
+}
+
+// fieldPathPattern matches a valid Odoo field path, e.g. "name" or
+// "partner_id.country_id.code".
+var fieldPathPattern = regexp.MustCompile(`^[a-z_][a-z0-9_.]*$`)
+
+// Expr is a node of a Domain expression tree: either a leaf clause (field,
+// operator, value) built with Clause, or a logical group built with And,
+// Or or Not.
+type Expr struct {
+	isLeaf bool
+	field  string
+	op     string
+	value  interface{}
+
+	operator string
+	operands []Expr
+}
+
+// Defines a Odoo Clause as a tuple(field name, operator, value), to be
+// combined into a Domain via Domain.And/Or/Not. field and op are validated
+// when the Domain is Build().
+func Clause(field string, op string, value interface{}) Expr {
+	return Expr{isLeaf: true, field: field, op: op, value: value}
+}
+
+// And returns an Expr grouping exprs under Odoo's "&" operator, for
+// nesting inside Domain.Or/Domain.Not or another And/Or.
+func And(exprs ...Expr) Expr {
+	return Expr{operator: OpAND, operands: exprs}
+}
+
+// Or returns an Expr grouping exprs under Odoo's "|" operator, for nesting
+// inside Domain.And/Domain.Not or another And/Or.
+func Or(exprs ...Expr) Expr {
+	return Expr{operator: OpOR, operands: exprs}
+}
+
+// Not returns an Expr negating expr under Odoo's "!" operator, for nesting
+// inside Domain.And/Domain.Or or another And/Or.
+func Not(expr Expr) Expr {
+	return Expr{operator: OpNOT, operands: []Expr{expr}}
+}
+
+// Domain is a typed Odoo search-domain builder. The zero value (and the
+// value returned by NewDomain) is a valid, empty domain. Combine
+// expressions with And, Or and Not, then call Build to obtain the flat
+// polish-notation slice the server expects.
+// Example:
+//		domain := odoo.NewDomain().
+//			And(odoo.Clause("active", "=", true)).
+//			Or(odoo.Clause("login", "=", "John"), odoo.Clause("login", "=", "Jane"))
+//		searchResult, err := client.Search("res.users", domain)
+type Domain struct {
+	exprs []Expr
+}
+
+// NewDomain returns an empty Domain ready to be built up with And, Or and
+// Not.
+func NewDomain() Domain {
+	return Domain{}
+}
+
+// And appends exprs to the domain. Because Odoo implicitly ANDs the
+// top-level terms of a domain, no explicit "&" operator is emitted.
+func (d Domain) And(exprs ...Expr) Domain {
+	return Domain{exprs: append(append([]Expr{}, d.exprs...), exprs...)}
+}
+
+// Or appends an explicit "|" group of exprs to the domain.
+func (d Domain) Or(exprs ...Expr) Domain {
+	if len(exprs) == 0 {
+		return d
+	}
+	return Domain{exprs: append(append([]Expr{}, d.exprs...), Or(exprs...))}
+}
+
+// Not appends an explicit "!" negation of expr to the domain.
+func (d Domain) Not(expr Expr) Domain {
+	return Domain{exprs: append(append([]Expr{}, d.exprs...), Not(expr))}
+}
+
+// DomainValidationError is returned by Domain.Build when the domain
+// contains unknown operators, malformed field paths, or unbalanced "&",
+// "|" or "!" operators.
+type DomainValidationError struct {
+	Violations []string
+}
+
+func (err *DomainValidationError) Error() string {
+	return fmt.Sprintf("odoo: invalid domain: %s", strings.Join(err.Violations, "; "))
+}
+
+// Build linearizes the domain into the flat []interface{} Odoo expects,
+// or a *DomainValidationError listing every violation found.
+func (d Domain) Build() ([]interface{}, error) {
+	var flat []interface{}
+	var violations []string
+	for _, expr := range d.exprs {
+		expr.serialize(&flat, &violations)
+	}
+	if reason := checkDomainArity(flat); reason != "" {
+		violations = append(violations, reason)
+	}
+	if len(violations) > 0 {
+		return nil, &DomainValidationError{Violations: violations}
+	}
+	return flat, nil
+}
+
+func (e Expr) serialize(out *[]interface{}, violations *[]string) {
+	if e.isLeaf {
+		if !fieldPathPattern.MatchString(e.field) {
+			*violations = append(*violations, fmt.Sprintf("invalid field path %q", e.field))
+		}
+		if !allowedOperators[e.op] {
+			*violations = append(*violations, fmt.Sprintf("unknown operator %q for field %q", e.op, e.field))
+		}
+		*out = append(*out, []interface{}{e.field, e.op, e.value})
+		return
+	}
+
+	if e.operator == OpNOT {
+		if len(e.operands) != 1 {
+			*violations = append(*violations, `"!" operator must wrap exactly one expression`)
+		}
+		*out = append(*out, OpNOT)
+		for _, operand := range e.operands {
+			operand.serialize(out, violations)
+		}
+		return
+	}
+
+	if len(e.operands) == 0 {
+		return
+	}
+
+	for i := 0; i < len(e.operands)-1; i++ {
+		*out = append(*out, e.operator)
+	}
+	for _, operand := range e.operands {
+		operand.serialize(out, violations)
+	}
+}
+
+// checkDomainArity walks a linearized domain with a stack counting how
+// many operands each "&"/"|" (binary) or "!" (unary) operator still
+// expects, as Odoo's own domain normalization does. It returns a
+// violation message if the stack isn't fully depleted once every token
+// has been consumed, meaning an operator was left without enough operands.
+func checkDomainArity(flat []interface{}) string {
+	var pending []int
+	for _, token := range flat {
+		if op, ok := token.(string); ok {
+			switch op {
+			case OpAND, OpOR:
+				pending = append(pending, 2)
+				continue
+			case OpNOT:
+				pending = append(pending, 1)
+				continue
+			}
+		}
+		for len(pending) > 0 {
+			pending[len(pending)-1]--
+			if pending[len(pending)-1] > 0 {
+				break
+			}
+			pending = pending[:len(pending)-1]
+		}
+	}
+	if len(pending) != 0 {
+		return "unbalanced logical operators: missing operands"
+	}
+	return ""
+}
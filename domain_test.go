@@ -0,0 +1,70 @@
+package odoo
+
+import "testing"
+
+func TestDomain_Build_ImplicitAnd(t *testing.T) {
+	domain := NewDomain().And(Clause("active", "=", true), Clause("login", "=", "john"))
+
+	built, err := domain.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []interface{}{
+		[]interface{}{"active", "=", true},
+		[]interface{}{"login", "=", "john"},
+	}
+	if len(built) != len(expected) {
+		t.Fatalf("got %v, want %v", built, expected)
+	}
+}
+
+func TestDomain_Build_Or(t *testing.T) {
+	domain := NewDomain().Or(Clause("login", "=", "john"), Clause("login", "=", "jane"))
+
+	built, err := domain.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(built) != 3 || built[0] != OpOR {
+		t.Fatalf("got %v, want a leading %q", built, OpOR)
+	}
+}
+
+func TestDomain_Build_Not(t *testing.T) {
+	domain := NewDomain().Not(Clause("active", "=", true))
+
+	built, err := domain.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(built) != 2 || built[0] != OpNOT {
+		t.Fatalf("got %v, want a leading %q", built, OpNOT)
+	}
+}
+
+func TestDomain_Build_UnknownOperator(t *testing.T) {
+	domain := NewDomain().And(Clause("active", "==", true))
+
+	if _, err := domain.Build(); err == nil {
+		t.Fatal("expected a DomainValidationError for an unknown operator")
+	}
+}
+
+func TestDomain_Build_InvalidFieldPath(t *testing.T) {
+	domain := NewDomain().And(Clause("Active Field", "=", true))
+
+	if _, err := domain.Build(); err == nil {
+		t.Fatal("expected a DomainValidationError for an invalid field path")
+	}
+}
+
+func TestDomain_Build_UnbalancedNot(t *testing.T) {
+	domain := Domain{exprs: []Expr{{operator: OpNOT}}}
+
+	if _, err := domain.Build(); err == nil {
+		t.Fatal("expected a DomainValidationError for a \"!\" operator missing its operand")
+	}
+}
@@ -3,15 +3,28 @@ package odoo
 import "fmt"
 
 // This error will be returned when an authentication will fail.
+// Cause holds the underlying transport or fault error when authentication
+// failed because the call itself errored out, and is nil when the server
+// simply rejected the credentials (Authenticate returning a false/zero uid).
 type ClientAuthError struct {
 	config *ClientConfig
-	error
+	Cause  error
 }
 
 func (err *ClientAuthError) Error() string {
-	return fmt.Sprintf(
-		"Cannot authenticate to url %s on %s with user %s and password %s",
-		err.config.Url, err.config.Db, err.config.Username, err.config.Password)
+	message := fmt.Sprintf(
+		"Cannot authenticate to url %s on %s with user %s and password ***",
+		err.config.Url, err.config.Db, err.config.Username)
+	if err.Cause != nil {
+		message = fmt.Sprintf("%s: %s", message, err.Cause)
+	}
+	return message
+}
+
+// Unwrap exposes Cause so callers can errors.Is/As against the underlying
+// transport or fault error.
+func (err *ClientAuthError) Unwrap() error {
+	return err.Cause
 }
 
 // This error will be returned when the odoo configuration is not valid
@@ -21,7 +34,15 @@ type InvalidConfigError struct {
 }
 
 func (err *InvalidConfigError) Error() string {
-	return fmt.Sprintf("Invalid Odoo configuration %s", err.config)
+	return fmt.Sprintf(
+		"Invalid Odoo configuration: url=%q db=%q username=%q",
+		err.config.Url, err.config.Db, err.config.Username)
+}
+
+// Unwrap exposes the wrapped error, if any, so callers can errors.Is/As
+// against it.
+func (err *InvalidConfigError) Unwrap() error {
+	return err.error
 }
 
 // This error will be returned when more than one context will be passed to a remote call.
@@ -32,3 +53,22 @@ type InvalidContextError struct {
 func (err *InvalidContextError) Error() string {
 	return fmt.Sprintf("Maximum one context variable is admitted.")
 }
+
+// Unwrap exposes the wrapped error, if any, so callers can errors.Is/As
+// against it.
+func (err *InvalidContextError) Unwrap() error {
+	return err.error
+}
+
+// OdooServerError wraps the "error" object an Odoo server reports inside a
+// JSON-RPC response envelope, preserving its code, message and debug
+// traceback instead of collapsing them into a single opaque fault string.
+type OdooServerError struct {
+	Code    int
+	Message string
+	Debug   string
+}
+
+func (err *OdooServerError) Error() string {
+	return fmt.Sprintf("odoo server error %d: %s", err.Code, err.Message)
+}
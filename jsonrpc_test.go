@@ -0,0 +1,91 @@
+package odoo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newJSONRPCTestServer serves a minimal canned /jsonrpc responder good
+// enough to drive Client.Authenticate/Create/Search without a live Odoo.
+func newJSONRPCTestServer(t *testing.T, uid int64) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req jsonrpcRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding jsonrpc request: %v", err)
+		}
+
+		var result interface{}
+		switch {
+		case req.Params.Service == "common" && req.Params.Method == "authenticate":
+			result = uid
+		case req.Params.Service == "object" && req.Params.Method == "execute_kw":
+			ormMethod, _ := req.Params.Args[4].(string)
+			switch ormMethod {
+			case "create":
+				result = 42
+			case "search":
+				result = []interface{}{1, 2, 3}
+			case "search_count":
+				result = 3
+			default:
+				t.Fatalf("unexpected orm method %q", ormMethod)
+			}
+		default:
+			t.Fatalf("unexpected call %s/%s", req.Params.Service, req.Params.Method)
+		}
+
+		_ = json.NewEncoder(w).Encode(jsonrpcResponse{Jsonrpc: "2.0", Id: req.Id, Result: result})
+	}))
+}
+
+// TestClient_JSONRPC_NormalizesIntegers exercises a canned JSON-RPC
+// response through Client.Authenticate/Create/Search, guarding against
+// encoding/json decoding Odoo's integer results as float64: every one of
+// these call sites type-asserts the result straight to int64.
+func TestClient_JSONRPC_NormalizesIntegers(t *testing.T) {
+	server := newJSONRPCTestServer(t, 7)
+	defer server.Close()
+
+	client, err := NewClient(&ClientConfig{
+		Url:      server.URL,
+		Db:       "test",
+		Username: "admin",
+		Password: "admin",
+		Protocol: ProtocolJSONRPC,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	if client.uid != 7 {
+		t.Fatalf("got uid %d, want 7", client.uid)
+	}
+
+	createResult, err := client.Create("res.partner", map[string]interface{}{"name": "Test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if createResult != 42 {
+		t.Fatalf("got create result %d, want 42", createResult)
+	}
+
+	searchResult, err := client.Search("res.partner", NewDomain())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(searchResult) != 3 || searchResult[0] != 1 {
+		t.Fatalf("got search result %v, want [1 2 3]", searchResult)
+	}
+
+	count, err := client.SearchCount("res.partner", NewDomain())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Fatalf("got search count %d, want 3", count)
+	}
+}
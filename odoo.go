@@ -1,5 +1,6 @@
-// Package odoo exposes an XML-RPC client specifically designed for
-// Odoo server (13, 12,11 officially supported).
+// Package odoo exposes a client specifically designed for Odoo server
+// (13, 12, 11 officially supported), speaking either XML-RPC (the
+// default) or JSON-RPC over the ClientConfig.Protocol setting.
 //
 // To start a connection with your Odoo server you have to build your
 // ClientConfig data before using the NewClient method to start a new
@@ -19,10 +20,21 @@
 //
 // Once authenticated, you can use the ExecuteKw method to perform a
 // non ORM call (for an example a specific model call), otherwise you
-// can use the standard ORM methods already wrapped.
+// can use the standard ORM methods already wrapped. Every method has a
+// *Context variant (ReadContext, SearchContext, ...) accepting a
+// context.Context for cancellation, timeouts and deadlines, and
+// ExecuteKwContext automatically re-authenticates and retries (up to
+// ClientConfig.MaxRetries times, once by default) when
+// ClientConfig.AutoReauth detects the session expired server-side.
+// Search, SearchRead and SearchCount take a Domain built with NewDomain
+// and its And/Or/Not methods, which validates operators and field paths
+// before linearizing to the polish-notation filter Odoo expects. Database
+// management RPCs (list, create, drop, dump, restore...) are available
+// through client.DB(), authenticated with the server's master password
+// rather than a user session.
 package odoo
 
-import "github.com/kolo/xmlrpc"
+import "context"
 
 // Defines the args that must be passed to ExecuteKw method.
 type Args []interface{}
@@ -32,38 +44,12 @@ func (args *Args) Append(arg ...interface{}) {
 	*args = append(*args, arg...)
 }
 
-// Define an abstract domain data structure.
-// Domain can be created using the NewDomain function an the related Clause
-// function for each clause included in domain.
-// Example:
-//		searchResult, err := client.Search("res.users", NewDomain(OpOR, Clause("active", "=", 1), Clause("login", "=", "John")))
-type Domain []interface{}
-
-// Defines the odoo domain operator AND
-const OpAND = "&"
-
-// Defines the odoo domain operator OR
-const OpOR = "|"
-
-// Defines a Odoo Clause as a tuple(field name, operator, value).
-// Can be used as parameter in NewDomain function.
-func Clause(field string, op string, values interface{}) interface{} {
-	return []interface{}{field, op, values}
-}
-
-// Create a new Odoo Domain as a list of clause (OpAND and OpOR are admitted too).
-func NewDomain(clauses ...interface{}) Domain {
-	// TODO Consistence check
-	return Domain{clauses}
-}
-
 // Client object will give you access to all remote methods.
 type Client struct {
-	cfg    *ClientConfig
-	uid    int64
-	auth   bool
-	common *xmlrpc.Client
-	object *xmlrpc.Client
+	cfg       *ClientConfig
+	uid       int64
+	auth      bool
+	transport Transport
 }
 
 // Check client authentication status.
@@ -82,35 +68,41 @@ func (client *Client) getArgs() Args {
 	return args
 }
 
-// Abstract method to perform a XML-RPC call to an odoo server.
-func (client *Client) call(c *xmlrpc.Client, method string, args []interface{}) (interface{}, error) {
-	var result interface{}
-	err := c.Call(method, args, &result)
-	return result, err
-}
-
-// Perform XML-RPC Call to odoo using xmlrpc/2/common endpoint
-func (client *Client) commonCall(method string, args Args) (interface{}, error) {
-	return client.call(client.common, method, args)
+// Perform the call to the "common" odoo service through the client's Transport.
+func (client *Client) commonCall(ctx context.Context, method string, args Args) (interface{}, error) {
+	return client.transport.Call(ctx, "common", method, args)
 }
 
-// Perform XML-RPC Call to odoo using xmlrpc/2/object endpoint
-func (client *Client) objectCall(method string, args Args) (interface{}, error) {
-	return client.call(client.object, method, args)
+// Perform the call to the "object" odoo service through the client's Transport.
+func (client *Client) objectCall(ctx context.Context, method string, args Args) (interface{}, error) {
+	return client.transport.Call(ctx, "object", method, args)
 }
 
 // Perform remote authentication to the Odoo url defined into the ClientConfig.
 func (client *Client) Authenticate() error {
-	var err error = nil
-	if !client.isAuthenticated() {
-		if uid, err := client.commonCall("authenticate", client.getArgs()); uid != 0 && err == nil {
-			client.uid = uid.(int64)
-			client.auth = true
-		} else {
-			err = &ClientAuthError{config: client.cfg}
-		}
+	return client.AuthenticateContext(context.Background())
+}
+
+// AuthenticateContext is the ctx-aware variant of Authenticate, honoring
+// ctx cancellation and deadlines while reaching the Odoo server.
+func (client *Client) AuthenticateContext(ctx context.Context) error {
+	if client.isAuthenticated() {
+		return nil
+	}
+
+	uid, err := client.commonCall(ctx, "authenticate", client.getArgs())
+	if err != nil {
+		return &ClientAuthError{config: client.cfg, Cause: err}
+	}
+
+	authenticatedUID, ok := uid.(int64)
+	if !ok || authenticatedUID == 0 {
+		return &ClientAuthError{config: client.cfg}
 	}
-	return err
+
+	client.uid = authenticatedUID
+	client.auth = true
+	return nil
 }
 
 // Close the client connection.
@@ -119,38 +111,30 @@ func (client *Client) Close() error {
 	client.uid = 0
 	client.auth = false
 
-	var err error = nil
-	if client.common != nil {
-		err = client.common.Close()
-	}
-
-	if err == nil && client.object != nil {
-		err = client.object.Close()
-	}
-	return err
+	return client.transport.Close()
 }
 
 // Call methods of odoo models via the execute_kw RPC function
 // (https://www.odoo.com/documentation/13.0/webservices/odoo.html#calling-methods).
-func (client *Client) ExecuteKw(method string, model string, args Args, context ...map[string]interface{}) (interface{}, error) {
+func (client *Client) ExecuteKw(method string, model string, args Args, odooContext ...map[string]interface{}) (interface{}, error) {
+	return client.ExecuteKwContext(context.Background(), method, model, args, odooContext...)
+}
+
+// ExecuteKwContext is the ctx-aware variant of ExecuteKw, honoring ctx
+// cancellation and deadlines while the call is in flight.
+func (client *Client) ExecuteKwContext(ctx context.Context, method string, model string, args Args, odooContext ...map[string]interface{}) (interface{}, error) {
 	var err error = nil
 	var result interface{} = nil
-	var params = client.getArgs()
-	params.Append(model, method, args)
-	//for _, arg := range args {
-	//	params.Append(arg)
-	//}
-
-	// context must be  0 or 1 value only
-	if len(context) == 1 {
-		params.Append(context[0])
-	} else if len(context) >= 1 {
+
+	// odooContext must be 0 or 1 value only
+	if len(odooContext) >= 2 {
 		err = &InvalidContextError{}
 	}
-	// if no context passed, this param will be ignored
+
 	if err == nil {
 		if client.isAuthenticated() {
-			result, err = client.objectCall("execute_kw", params)
+			refresher := &sessionRefresher{client: client}
+			result, err = refresher.call(ctx, method, model, args, odooContext)
 		} else {
 			err = &ClientAuthError{config: client.cfg}
 		}
@@ -162,12 +146,17 @@ func (client *Client) ExecuteKw(method string, model string, args Args, context
 // (as returned by search()) and optionally a list of fields to fetch.
 // By default, it will fetch all the fields the current user can read, which tends to be a huge amount
 // (https://www.odoo.com/documentation/13.0/webservices/odoo.html#read-records).
-func (client *Client) Read(model string, ids []int64, fields []string, context ...map[string]interface{}) (interface{}, error) {
+func (client *Client) Read(model string, ids []int64, fields []string, odooContext ...map[string]interface{}) (interface{}, error) {
+	return client.ReadContext(context.Background(), model, ids, fields, odooContext...)
+}
+
+// ReadContext is the ctx-aware variant of Read.
+func (client *Client) ReadContext(ctx context.Context, model string, ids []int64, fields []string, odooContext ...map[string]interface{}) (interface{}, error) {
 	args := Args{ids}
 	if len(fields) != 0 {
 		args.Append(fields)
 	}
-	return client.ExecuteKw("read", model, args, context...)
+	return client.ExecuteKwContext(ctx, "read", model, args, odooContext...)
 }
 
 // Records of a model are created using Create(). The method will create a single
@@ -175,9 +164,14 @@ func (client *Client) Read(model string, ids []int64, fields []string, context .
 // to values, used to initialize the record. For any field which has a default
 // value and is not set through the mapping argument, the default value will be used.
 // (https://www.odoo.com/documentation/13.0/webservices/odoo.html#create-records).
-func (client *Client) Create(model string, values map[string]interface{}, context ...map[string]interface{}) (int64, error) {
+func (client *Client) Create(model string, values map[string]interface{}, odooContext ...map[string]interface{}) (int64, error) {
+	return client.CreateContext(context.Background(), model, values, odooContext...)
+}
+
+// CreateContext is the ctx-aware variant of Create.
+func (client *Client) CreateContext(ctx context.Context, model string, values map[string]interface{}, odooContext ...map[string]interface{}) (int64, error) {
 	var result int64 = 0
-	response, err := client.ExecuteKw("create", model, Args{values}, context...)
+	response, err := client.ExecuteKwContext(ctx, "create", model, Args{values}, odooContext...)
 	if err == nil {
 		result = response.(int64)
 	}
@@ -187,9 +181,14 @@ func (client *Client) Create(model string, values map[string]interface{}, contex
 // Records can be updated using Write(). it takes a list of records to
 // update and a mapping of updated fields to values similar to create()
 // (https://www.odoo.com/documentation/13.0/webservices/odoo.html#update-records).
-func (client *Client) Write(model string, ids []int64, values map[string]interface{}, context ...map[string]interface{}) (bool, error) {
+func (client *Client) Write(model string, ids []int64, values map[string]interface{}, odooContext ...map[string]interface{}) (bool, error) {
+	return client.WriteContext(context.Background(), model, ids, values, odooContext...)
+}
+
+// WriteContext is the ctx-aware variant of Write.
+func (client *Client) WriteContext(ctx context.Context, model string, ids []int64, values map[string]interface{}, odooContext ...map[string]interface{}) (bool, error) {
 	var result = false
-	response, err := client.ExecuteKw("write", model, Args{ids, values}, context...)
+	response, err := client.ExecuteKwContext(ctx, "write", model, Args{ids, values}, odooContext...)
 	if err == nil {
 		result = response.(bool)
 	}
@@ -198,9 +197,14 @@ func (client *Client) Write(model string, ids []int64, values map[string]interfa
 
 // Records can be deleted in bulk by providing their ids to Unlink()
 // (https://www.odoo.com/documentation/13.0/webservices/odoo.html#delete-records).
-func (client *Client) Unlink(model string, ids []int64, context ...map[string]interface{}) (bool, error) {
+func (client *Client) Unlink(model string, ids []int64, odooContext ...map[string]interface{}) (bool, error) {
+	return client.UnlinkContext(context.Background(), model, ids, odooContext...)
+}
+
+// UnlinkContext is the ctx-aware variant of Unlink.
+func (client *Client) UnlinkContext(ctx context.Context, model string, ids []int64, odooContext ...map[string]interface{}) (bool, error) {
 	var result = false
-	response, err := client.ExecuteKw("unlink", model, Args{ids}, context...)
+	response, err := client.ExecuteKwContext(ctx, "unlink", model, Args{ids}, odooContext...)
 	if err == nil {
 		result = response.(bool)
 	}
@@ -211,13 +215,18 @@ func (client *Client) Unlink(model string, ids []int64, context ...map[string]in
 // It takes a mandatory domain filter (possibly empty), and returns
 // the database identifiers of all records matching the filter
 // (https://www.odoo.com/documentation/13.0/webservices/odoo.html#list-records).
-func (client *Client) Search(model string, domain Domain, context ...map[string]interface{}) ([]int64, error) {
+func (client *Client) Search(model string, domain Domain, odooContext ...map[string]interface{}) ([]int64, error) {
+	return client.SearchContext(context.Background(), model, domain, odooContext...)
+}
+
+// SearchContext is the ctx-aware variant of Search.
+func (client *Client) SearchContext(ctx context.Context, model string, domain Domain, odooContext ...map[string]interface{}) ([]int64, error) {
 	var result []int64 = nil
-	var args = Args{}
-	for _, clause := range domain {
-		args.Append(clause)
+	built, err := domain.Build()
+	if err != nil {
+		return nil, err
 	}
-	response, err := client.ExecuteKw("search", model, args, context...)
+	response, err := client.ExecuteKwContext(ctx, "search", model, Args{built}, odooContext...)
 	if err == nil {
 		result = make([]int64, len(response.([]interface{})))
 		for i, r := range response.([]interface{}) {
@@ -233,21 +242,39 @@ func (client *Client) Search(model string, domain Domain, context ...map[string]
 // take a list of fields (like Read(), if that list is not provided it will fetch
 // all fields of matched records)
 // (https://www.odoo.com/documentation/13.0/webservices/odoo.html#search-and-read).
-func (client *Client) SearchRead(model string, domain Domain, fields []string, context ...map[string]interface{}) (interface{}, error) {
-	args := Args{domain}
+func (client *Client) SearchRead(model string, domain Domain, fields []string, odooContext ...map[string]interface{}) (interface{}, error) {
+	return client.SearchReadContext(context.Background(), model, domain, fields, odooContext...)
+}
+
+// SearchReadContext is the ctx-aware variant of SearchRead.
+func (client *Client) SearchReadContext(ctx context.Context, model string, domain Domain, fields []string, odooContext ...map[string]interface{}) (interface{}, error) {
+	built, err := domain.Build()
+	if err != nil {
+		return nil, err
+	}
+	args := Args{built}
 	if len(fields) != 0 {
 		args.Append(fields)
 	}
-	return client.ExecuteKw("search_read", model, args, context...)
+	return client.ExecuteKwContext(ctx, "search_read", model, args, odooContext...)
 }
 
 // SearchCount() can be used to retrieve only the number of records
 // matching the query. It takes the same domain filter as search()
 // and no other parameter
 // (https://www.odoo.com/documentation/13.0/webservices/odoo.html#count-records).
-func (client *Client) SearchCount(model string, domain Domain, context ...map[string]interface{}) (int64, error) {
+func (client *Client) SearchCount(model string, domain Domain, odooContext ...map[string]interface{}) (int64, error) {
+	return client.SearchCountContext(context.Background(), model, domain, odooContext...)
+}
+
+// SearchCountContext is the ctx-aware variant of SearchCount.
+func (client *Client) SearchCountContext(ctx context.Context, model string, domain Domain, odooContext ...map[string]interface{}) (int64, error) {
 	var result int64 = 0
-	response, err := client.ExecuteKw("search_count", model, Args{domain}, context...)
+	built, err := domain.Build()
+	if err != nil {
+		return result, err
+	}
+	response, err := client.ExecuteKwContext(ctx, "search_count", model, Args{built}, odooContext...)
 	if err == nil {
 		result = response.(int64)
 	}
@@ -262,18 +289,19 @@ func NewClient(config *ClientConfig) (*Client, error) {
 	var err error = nil
 	var client *Client = nil
 	if config.IsValid() {
-		var common, object *xmlrpc.Client
-		common, err = xmlrpc.NewClient(config.Url+"/xmlrpc/2/common", nil)
-		if err == nil {
-			object, err = xmlrpc.NewClient(config.Url+"/xmlrpc/2/object", nil)
+		var transport Transport
+		switch config.Protocol {
+		case ProtocolJSONRPC:
+			transport = newJSONRPCTransport(config)
+		default:
+			transport, err = newXMLRPCTransport(config)
 		}
 		if err == nil {
 			client = &Client{
-				cfg:    config,
-				uid:    0,
-				auth:   false,
-				common: common,
-				object: object,
+				cfg:       config,
+				uid:       0,
+				auth:      false,
+				transport: transport,
 			}
 			if err = client.Authenticate(); err != nil {
 				client = nil
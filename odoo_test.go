@@ -37,7 +37,7 @@ func TestClient_Read(t *testing.T) {
 func TestClient_Search(t *testing.T) {
 	client, _ := NewClient(config)
 
-	searchResult, err := client.Search("res.users", NewDomain(Clause("active", "=", 1)))
+	searchResult, err := client.Search("res.users", NewDomain().And(Clause("active", "=", 1)))
 	if err != nil {
 		t.Fatal(err)
 	}
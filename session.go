@@ -0,0 +1,82 @@
+package odoo
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kolo/xmlrpc"
+)
+
+// sessionRefresher wraps the execute_kw call with the retry pattern used by
+// go-oidc's AuthenticatedTransport/TokenRefresher: when Odoo reports the
+// session was invalidated server-side (restart, worker recycle, password
+// change), re-authenticate to obtain a fresh uid and retry (up to
+// ClientConfig.MaxRetries times, once by default) before surfacing the error.
+type sessionRefresher struct {
+	client *Client
+}
+
+// call performs one execute_kw RPC, re-authenticating and retrying (up to
+// ClientConfig.MaxRetries times) if the response indicates the session
+// expired and ClientConfig.AutoReauth is enabled.
+func (refresher *sessionRefresher) call(ctx context.Context, method string, model string, args Args, odooContext []map[string]interface{}) (interface{}, error) {
+	client := refresher.client
+
+	buildParams := func() Args {
+		params := client.getArgs()
+		params.Append(model, method, args)
+		if len(odooContext) == 1 {
+			params.Append(odooContext[0])
+		}
+		return params
+	}
+
+	result, err := client.objectCall(ctx, "execute_kw", buildParams())
+	if err == nil || !client.cfg.autoReauth() || !isSessionExpired(err) {
+		return result, err
+	}
+
+	oldUID := client.uid
+	for attempt := 0; attempt < client.cfg.maxRetries(); attempt++ {
+		client.uid = 0
+		client.auth = false
+		if reauthErr := client.AuthenticateContext(ctx); reauthErr != nil {
+			return nil, reauthErr
+		}
+		if client.cfg.OnReauth != nil {
+			client.cfg.OnReauth(oldUID, client.uid)
+		}
+
+		result, err = client.objectCall(ctx, "execute_kw", buildParams())
+		if err == nil || !isSessionExpired(err) {
+			return result, err
+		}
+	}
+	return result, err
+}
+
+// isSessionExpired reports whether err indicates Odoo invalidated the
+// session server-side rather than a genuine authorization failure, so the
+// caller knows it's safe to re-authenticate and retry instead of
+// surfacing the error as-is. Odoo's plain AccessDenied exception also
+// renders as "Access Denied" for a wrong password or a real ACL
+// violation, neither of which a reauth with the same stored credentials
+// can fix, so that bare substring is deliberately not treated as a
+// session-expiry signal here.
+func isSessionExpired(err error) bool {
+	var message string
+	switch fault := err.(type) {
+	case xmlrpc.FaultError:
+		message = fault.String
+	case *OdooServerError:
+		message = fault.Message + " " + fault.Debug
+	default:
+		message = err.Error()
+	}
+
+	message = strings.ToLower(message)
+	return strings.Contains(message, "session expired") ||
+		strings.Contains(message, "sessionexpired") ||
+		strings.Contains(message, "session invalid") ||
+		strings.Contains(message, "invalid session")
+}
@@ -0,0 +1,28 @@
+package odoo
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIsSessionExpired(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"session expired", errors.New("Session Expired"), true},
+		{"session invalid", errors.New("Session Invalid"), true},
+		{"access denied", errors.New("Access Denied"), false},
+		{"wrong credentials", errors.New("Access Denied: invalid username or password"), false},
+		{"unrelated fault", errors.New("ir.ui.view: record not found"), false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := isSessionExpired(c.err); got != c.want {
+				t.Errorf("isSessionExpired(%q) = %v, want %v", c.err, got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package odoo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kolo/xmlrpc"
+)
+
+// Transport abstracts the wire protocol used to exchange calls with an
+// Odoo server. Client routes every remote call through a Transport so its
+// methods behave identically regardless of which protocol is selected via
+// ClientConfig.Protocol.
+type Transport interface {
+	// Call invokes method on the given Odoo service ("common", "object"
+	// or "db") with args and returns the decoded result, honoring ctx
+	// cancellation and deadlines while the call is in flight.
+	Call(ctx context.Context, service string, method string, args []interface{}) (interface{}, error)
+	// Close releases any resource held by the transport.
+	Close() error
+}
+
+// xmlrpcTransport is the historical Transport implementation, backed by
+// one github.com/kolo/xmlrpc client per Odoo service endpoint
+// (/xmlrpc/2/common, /xmlrpc/2/object, /xmlrpc/2/db).
+type xmlrpcTransport struct {
+	clients map[string]*xmlrpc.Client
+	timeout time.Duration
+}
+
+func newXMLRPCTransport(config *ClientConfig) (*xmlrpcTransport, error) {
+	roundTripper := http.DefaultTransport
+	if config.HTTPClient != nil && config.HTTPClient.Transport != nil {
+		roundTripper = config.HTTPClient.Transport
+	}
+
+	transport := &xmlrpcTransport{clients: make(map[string]*xmlrpc.Client), timeout: config.Timeout}
+	for _, service := range []string{"common", "object", "db"} {
+		client, err := xmlrpc.NewClient(config.Url+"/xmlrpc/2/"+service, roundTripper)
+		if err != nil {
+			transport.Close()
+			return nil, err
+		}
+		transport.clients[service] = client
+	}
+	return transport, nil
+}
+
+// withTimeout derives a context bound by transport.timeout when ctx does not
+// already carry a deadline. kolo/xmlrpc offers no context support of its
+// own, so Call still has to race the blocking RPC against ctx.Done() on a
+// goroutine.
+func (transport *xmlrpcTransport) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if transport.timeout <= 0 {
+		return ctx, func() {}
+	}
+	if _, hasDeadline := ctx.Deadline(); hasDeadline {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, transport.timeout)
+}
+
+func (transport *xmlrpcTransport) Call(ctx context.Context, service string, method string, args []interface{}) (interface{}, error) {
+	client, ok := transport.clients[service]
+	if !ok {
+		return nil, fmt.Errorf("odoo: unknown service %q", service)
+	}
+
+	ctx, cancel := transport.withTimeout(ctx)
+	defer cancel()
+
+	type callResult struct {
+		result interface{}
+		err    error
+	}
+	done := make(chan callResult, 1)
+	go func() {
+		var result interface{}
+		err := client.Call(method, args, &result)
+		done <- callResult{result, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-done:
+		return res.result, res.err
+	}
+}
+
+func (transport *xmlrpcTransport) Close() error {
+	var err error = nil
+	for _, client := range transport.clients {
+		if client == nil {
+			continue
+		}
+		if closeErr := client.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}
+	return err
+}
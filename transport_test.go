@@ -0,0 +1,51 @@
+package odoo
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// countingRoundTripper wraps another http.RoundTripper to record how many
+// times it was used.
+type countingRoundTripper struct {
+	calls int
+	next  http.RoundTripper
+}
+
+func (rt *countingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.calls++
+	return rt.next.RoundTrip(req)
+}
+
+// TestNewXMLRPCTransport_UsesConfiguredRoundTripper guards against
+// ClientConfig.HTTPClient being silently ignored by the xmlrpc transport,
+// which is the default protocol: the http.RoundTripper configured via
+// HTTPClient must reach the wire, not just the jsonrpc transport.
+func TestNewXMLRPCTransport_UsesConfiguredRoundTripper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = w.Write([]byte(`<?xml version="1.0"?><methodResponse><params><param><value><int>7</int></value></param></params></methodResponse>`))
+	}))
+	defer server.Close()
+
+	roundTripper := &countingRoundTripper{next: http.DefaultTransport}
+	transport, err := newXMLRPCTransport(&ClientConfig{
+		Url:        server.URL,
+		HTTPClient: &http.Client{Transport: roundTripper},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer transport.Close()
+
+	if _, err := transport.Call(context.Background(), "common", "authenticate", []interface{}{"db", "u", "p", ""}); err != nil {
+		t.Fatal(err)
+	}
+	if roundTripper.calls == 0 {
+		t.Fatal("expected the configured http.RoundTripper to be used, but it was never invoked")
+	}
+}
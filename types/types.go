@@ -0,0 +1,86 @@
+// Package types holds the Go representations the generated model bindings
+// (see cmd/odoo-gen) use for Odoo field values that don't map cleanly onto
+// a native Go type: many2one tuples and the "false" Odoo sends in place of
+// an empty string/datetime.
+package types
+
+import "fmt"
+
+// Many2One represents an Odoo many2one field. The server reports it as a
+// two-element [id, display_name] tuple, or as the boolean false when the
+// field is unset.
+type Many2One struct {
+	ID      int64
+	Display string
+}
+
+// FromOdoo decodes a many2one field as returned by the ORM (either
+// []interface{}{id, name} or false) into m.
+func (m *Many2One) FromOdoo(value interface{}) error {
+	switch v := value.(type) {
+	case bool:
+		*m = Many2One{}
+		return nil
+	case []interface{}:
+		if len(v) != 2 {
+			return fmt.Errorf("odoo: many2one tuple has %d elements, want 2", len(v))
+		}
+		id, ok := v[0].(int64)
+		if !ok {
+			return fmt.Errorf("odoo: many2one id has unexpected type %T", v[0])
+		}
+		display, ok := v[1].(string)
+		if !ok {
+			return fmt.Errorf("odoo: many2one display name has unexpected type %T", v[1])
+		}
+		*m = Many2One{ID: id, Display: display}
+		return nil
+	default:
+		return fmt.Errorf("odoo: unexpected many2one value type %T", value)
+	}
+}
+
+// IsSet reports whether the field was populated (non-false) by the server.
+func (m Many2One) IsSet() bool {
+	return m.ID != 0
+}
+
+// String is a nullable Odoo char/text/html/datetime field, which the
+// server reports as false rather than an empty string when unset.
+type String struct {
+	Value string
+	Valid bool
+}
+
+// FromOdoo decodes a string-like field as returned by the ORM (either a
+// string or false) into s.
+func (s *String) FromOdoo(value interface{}) error {
+	switch v := value.(type) {
+	case bool:
+		*s = String{}
+		return nil
+	case string:
+		*s = String{Value: v, Valid: true}
+		return nil
+	default:
+		return fmt.Errorf("odoo: unexpected string field value type %T", value)
+	}
+}
+
+// ToMany2Many decodes a one2many/many2many field as returned by the ORM
+// (a list of record ids) into a []int64.
+func ToMany2Many(value interface{}) ([]int64, error) {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("odoo: unexpected x2many field value type %T", value)
+	}
+	result := make([]int64, len(items))
+	for i, item := range items {
+		id, ok := item.(int64)
+		if !ok {
+			return nil, fmt.Errorf("odoo: x2many id has unexpected type %T", item)
+		}
+		result[i] = id
+	}
+	return result, nil
+}
@@ -0,0 +1,99 @@
+package types
+
+import "testing"
+
+func TestMany2One_FromOdoo(t *testing.T) {
+	t.Run("false means unset", func(t *testing.T) {
+		var m Many2One
+		if err := m.FromOdoo(false); err != nil {
+			t.Fatal(err)
+		}
+		if m.IsSet() {
+			t.Fatalf("got %+v, want an unset Many2One", m)
+		}
+	})
+
+	t.Run("tuple", func(t *testing.T) {
+		var m Many2One
+		if err := m.FromOdoo([]interface{}{int64(7), "Azure Interior"}); err != nil {
+			t.Fatal(err)
+		}
+		if !m.IsSet() || m.ID != 7 || m.Display != "Azure Interior" {
+			t.Fatalf("got %+v, want {ID:7 Display:Azure Interior}", m)
+		}
+	})
+
+	t.Run("malformed tuple arity", func(t *testing.T) {
+		var m Many2One
+		if err := m.FromOdoo([]interface{}{int64(7)}); err == nil {
+			t.Fatal("expected an error for a 1-element tuple")
+		}
+	})
+
+	t.Run("malformed tuple id type", func(t *testing.T) {
+		var m Many2One
+		if err := m.FromOdoo([]interface{}{"not-an-id", "name"}); err == nil {
+			t.Fatal("expected an error for a non-int64 id")
+		}
+	})
+
+	t.Run("unexpected type", func(t *testing.T) {
+		var m Many2One
+		if err := m.FromOdoo(42); err == nil {
+			t.Fatal("expected an error for an unexpected value type")
+		}
+	})
+}
+
+func TestString_FromOdoo(t *testing.T) {
+	t.Run("false means unset", func(t *testing.T) {
+		var s String
+		if err := s.FromOdoo(false); err != nil {
+			t.Fatal(err)
+		}
+		if s.Valid {
+			t.Fatalf("got %+v, want an invalid String", s)
+		}
+	})
+
+	t.Run("value", func(t *testing.T) {
+		var s String
+		if err := s.FromOdoo("hello"); err != nil {
+			t.Fatal(err)
+		}
+		if !s.Valid || s.Value != "hello" {
+			t.Fatalf("got %+v, want {Value:hello Valid:true}", s)
+		}
+	})
+
+	t.Run("unexpected type", func(t *testing.T) {
+		var s String
+		if err := s.FromOdoo(42); err == nil {
+			t.Fatal("expected an error for an unexpected value type")
+		}
+	})
+}
+
+func TestToMany2Many(t *testing.T) {
+	t.Run("ids", func(t *testing.T) {
+		ids, err := ToMany2Many([]interface{}{int64(1), int64(2), int64(3)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ids) != 3 || ids[0] != 1 || ids[1] != 2 || ids[2] != 3 {
+			t.Fatalf("got %v, want [1 2 3]", ids)
+		}
+	})
+
+	t.Run("malformed id type", func(t *testing.T) {
+		if _, err := ToMany2Many([]interface{}{"not-an-id"}); err == nil {
+			t.Fatal("expected an error for a non-int64 id")
+		}
+	})
+
+	t.Run("unexpected type", func(t *testing.T) {
+		if _, err := ToMany2Many(42); err == nil {
+			t.Fatal("expected an error for an unexpected value type")
+		}
+	})
+}